@@ -0,0 +1,246 @@
+// Package adapter adapts a *bingx.Client to a venue-neutral Exchange
+// interface, so strategy code can be written once and run against BingX or
+// any other exchange that implements the same interface.
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/suhostersky/bingx"
+)
+
+// Side is a neutral order side.
+type Side string
+
+// Neutral order sides.
+const (
+	SideBuy  Side = "BUY"
+	SideSell Side = "SELL"
+)
+
+// OrderType is a neutral order type.
+type OrderType string
+
+// Neutral order types.
+const (
+	OrderTypeMarket = OrderType("MARKET")
+	OrderTypeLimit  = OrderType("LIMIT")
+)
+
+// TimeInForce is a neutral time-in-force.
+type TimeInForce string
+
+// Neutral time-in-force values.
+const (
+	TimeInForceGTC TimeInForce = "GTC"
+	TimeInForceIOC TimeInForce = "IOC"
+	TimeInForceFOK TimeInForce = "FOK"
+)
+
+// OrderStatus is a neutral order status.
+type OrderStatus string
+
+// Neutral order statuses.
+const (
+	OrderStatusNew      OrderStatus = "NEW"
+	OrderStatusPartial  OrderStatus = "PARTIALLY_FILLED"
+	OrderStatusFilled   OrderStatus = "FILLED"
+	OrderStatusCanceled OrderStatus = "CANCELED"
+	OrderStatusRejected OrderStatus = "REJECTED"
+	OrderStatusUnknown  OrderStatus = "UNKNOWN"
+)
+
+// GenericOrder is a venue-neutral order request.
+type GenericOrder struct {
+	Symbol        string
+	Side          Side
+	Type          OrderType
+	TimeInForce   TimeInForce
+	Price         *bingx.Decimal // nil for MARKET orders
+	Quantity      bingx.Decimal
+	ReduceOnly    bool
+	ClientOrderID string
+}
+
+// GenericOrderResult is the venue-neutral result of placing an order.
+type GenericOrderResult struct {
+	OrderID       string
+	ClientOrderID string
+	Status        OrderStatus
+}
+
+// GenericPosition is a venue-neutral open position.
+type GenericPosition struct {
+	Symbol           string
+	Side             Side
+	Quantity         string
+	EntryPrice       string
+	UnrealizedProfit string
+}
+
+// GenericBalance is a venue-neutral asset balance.
+type GenericBalance struct {
+	Asset     string
+	Available string
+	Total     string
+}
+
+// Exchange is implemented by every venue adapter so strategy code can stay
+// venue-agnostic.
+type Exchange interface {
+	PlaceOrder(ctx context.Context, order GenericOrder) (GenericOrderResult, error)
+	CancelOrder(ctx context.Context, symbol, orderID string) error
+	GetBalance(ctx context.Context) ([]GenericBalance, error)
+	GetPositions(ctx context.Context, symbol string) ([]GenericPosition, error)
+	SubscribeKlines(ctx context.Context, symbol, interval string, handler func(bingx.Kline)) error
+}
+
+// bingxAdapter adapts a *bingx.Client to the Exchange interface.
+type bingxAdapter struct {
+	client *bingx.Client
+}
+
+// NewAdapter wraps client so it satisfies the Exchange interface.
+func NewAdapter(client *bingx.Client) Exchange {
+	return &bingxAdapter{client: client}
+}
+
+// PlaceOrder translates order into a bingx.PlaceOrderRequest and places it.
+func (a *bingxAdapter) PlaceOrder(ctx context.Context, order GenericOrder) (GenericOrderResult, error) {
+	req, err := toPlaceOrderRequest(order)
+	if err != nil {
+		return GenericOrderResult{}, err
+	}
+
+	resp, err := a.client.PlaceOrder(ctx, req)
+	if err != nil {
+		return GenericOrderResult{}, err
+	}
+
+	return GenericOrderResult{
+		OrderID:       resp.Data.OrderID,
+		ClientOrderID: resp.Data.ClientOrderID,
+		Status:        toOrderStatus(resp.Data.Status),
+	}, nil
+}
+
+// CancelOrder cancels a single order by orderID.
+func (a *bingxAdapter) CancelOrder(ctx context.Context, symbol, orderID string) error {
+	_, err := a.client.CancelOrder(ctx, bingx.CancelOrderRequest{
+		Symbol:  symbol,
+		OrderID: orderID,
+	})
+	return err
+}
+
+// GetBalance returns every asset balance on the account.
+func (a *bingxAdapter) GetBalance(ctx context.Context) ([]GenericBalance, error) {
+	resp, err := a.client.GetBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]GenericBalance, 0, len(resp.Data))
+	for _, b := range resp.Data {
+		balances = append(balances, GenericBalance{
+			Asset:     b.Asset,
+			Available: b.AvailableMargin,
+			Total:     b.Balance,
+		})
+	}
+	return balances, nil
+}
+
+// GetPositions returns open positions, optionally filtered by symbol.
+func (a *bingxAdapter) GetPositions(ctx context.Context, symbol string) ([]GenericPosition, error) {
+	resp, err := a.client.GetPositions(ctx, bingx.GetPositionsRequest{Symbol: symbol})
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]GenericPosition, 0, len(resp.Data))
+	for _, p := range resp.Data {
+		positions = append(positions, GenericPosition{
+			Symbol:           p.Symbol,
+			Side:             toSide(p.PositionSide),
+			Quantity:         p.PositionAmt,
+			EntryPrice:       p.EntryPrice,
+			UnrealizedProfit: p.UnrealizedProfit,
+		})
+	}
+	return positions, nil
+}
+
+// SubscribeKlines is not implemented directly on the REST-only adapter;
+// callers needing streaming klines should use bingx/ws.PublicClient and
+// wire its SubscribeKline callback to handler themselves.
+func (a *bingxAdapter) SubscribeKlines(ctx context.Context, symbol, interval string, handler func(bingx.Kline)) error {
+	return fmt.Errorf("bingx/adapter: SubscribeKlines requires a bingx/ws.PublicClient; the REST adapter does not stream")
+}
+
+// toPlaceOrderRequest converts a neutral GenericOrder into the BingX
+// PlaceOrderRequest shape, mapping neutral enums onto BingX's string
+// constants.
+func toPlaceOrderRequest(order GenericOrder) (bingx.PlaceOrderRequest, error) {
+	req := bingx.PlaceOrderRequest{
+		Symbol:        order.Symbol,
+		Side:          string(order.Side),
+		Type:          toBingxOrderType(order.Type),
+		TimeInForce:   string(order.TimeInForce),
+		Quantity:      &order.Quantity,
+		ClientOrderID: order.ClientOrderID,
+	}
+
+	if order.ReduceOnly {
+		req.ReduceOnly = bingx.BoolTrue
+	}
+
+	if order.Type == OrderTypeLimit {
+		if order.Price == nil {
+			return bingx.PlaceOrderRequest{}, fmt.Errorf("bingx/adapter: LIMIT order requires a price")
+		}
+		req.Price = order.Price
+	}
+
+	return req, nil
+}
+
+func toBingxOrderType(t OrderType) string {
+	switch t {
+	case OrderTypeMarket:
+		return bingx.OrderTypeMarket
+	case OrderTypeLimit:
+		return bingx.OrderTypeLimit
+	default:
+		return string(t)
+	}
+}
+
+func toSide(positionSide string) Side {
+	switch positionSide {
+	case bingx.PositionSideLong:
+		return SideBuy
+	case bingx.PositionSideShort:
+		return SideSell
+	default:
+		return Side(positionSide)
+	}
+}
+
+func toOrderStatus(status string) OrderStatus {
+	switch status {
+	case bingx.OrderStatusNew:
+		return OrderStatusNew
+	case bingx.OrderStatusPartiallyFilled:
+		return OrderStatusPartial
+	case bingx.OrderStatusFilled:
+		return OrderStatusFilled
+	case bingx.OrderStatusCanceled:
+		return OrderStatusCanceled
+	case bingx.OrderStatusRejected:
+		return OrderStatusRejected
+	default:
+		return OrderStatusUnknown
+	}
+}
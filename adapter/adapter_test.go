@@ -0,0 +1,113 @@
+package adapter
+
+import (
+	"testing"
+
+	"github.com/suhostersky/bingx"
+)
+
+func TestToBingxOrderType(t *testing.T) {
+	tests := []struct {
+		in   OrderType
+		want string
+	}{
+		{OrderTypeMarket, bingx.OrderTypeMarket},
+		{OrderTypeLimit, bingx.OrderTypeLimit},
+		{OrderType("STOP_MARKET"), "STOP_MARKET"}, // unrecognized, passed through
+	}
+
+	for _, tt := range tests {
+		if got := toBingxOrderType(tt.in); got != tt.want {
+			t.Errorf("toBingxOrderType(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestToSide(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Side
+	}{
+		{bingx.PositionSideLong, SideBuy},
+		{bingx.PositionSideShort, SideSell},
+		{"BOTH", Side("BOTH")}, // unrecognized, passed through
+	}
+
+	for _, tt := range tests {
+		if got := toSide(tt.in); got != tt.want {
+			t.Errorf("toSide(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestToOrderStatus(t *testing.T) {
+	tests := []struct {
+		in   string
+		want OrderStatus
+	}{
+		{bingx.OrderStatusNew, OrderStatusNew},
+		{bingx.OrderStatusPartiallyFilled, OrderStatusPartial},
+		{bingx.OrderStatusFilled, OrderStatusFilled},
+		{bingx.OrderStatusCanceled, OrderStatusCanceled},
+		{bingx.OrderStatusRejected, OrderStatusRejected},
+		{"SOMETHING_ELSE", OrderStatusUnknown},
+	}
+
+	for _, tt := range tests {
+		if got := toOrderStatus(tt.in); got != tt.want {
+			t.Errorf("toOrderStatus(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestToPlaceOrderRequest(t *testing.T) {
+	qty := bingx.DecimalFromFloat(0.5)
+	price := bingx.DecimalFromFloat(100)
+
+	t.Run("limit order requires price", func(t *testing.T) {
+		_, err := toPlaceOrderRequest(GenericOrder{
+			Symbol:   "BTC-USDT",
+			Side:     SideBuy,
+			Type:     OrderTypeLimit,
+			Quantity: qty,
+		})
+		if err == nil {
+			t.Fatal("expected an error for a LIMIT order with no price")
+		}
+	})
+
+	t.Run("limit order with price", func(t *testing.T) {
+		req, err := toPlaceOrderRequest(GenericOrder{
+			Symbol:   "BTC-USDT",
+			Side:     SideBuy,
+			Type:     OrderTypeLimit,
+			Price:    &price,
+			Quantity: qty,
+		})
+		if err != nil {
+			t.Fatalf("toPlaceOrderRequest: %v", err)
+		}
+		if req.Price == nil || !req.Price.Equal(price) {
+			t.Errorf("expected Price to be set to %s, got %v", price, req.Price)
+		}
+	})
+
+	t.Run("market order", func(t *testing.T) {
+		req, err := toPlaceOrderRequest(GenericOrder{
+			Symbol:     "BTC-USDT",
+			Side:       SideSell,
+			Type:       OrderTypeMarket,
+			Quantity:   qty,
+			ReduceOnly: true,
+		})
+		if err != nil {
+			t.Fatalf("toPlaceOrderRequest: %v", err)
+		}
+		if req.Price != nil {
+			t.Errorf("expected no Price on a MARKET order, got %v", req.Price)
+		}
+		if req.ReduceOnly != bingx.BoolTrue {
+			t.Errorf("expected ReduceOnly to be set, got %q", req.ReduceOnly)
+		}
+	})
+}
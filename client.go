@@ -13,8 +13,10 @@ import (
 	"math"
 	"net/http"
 	"net/url"
+	"reflect"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -31,18 +33,75 @@ var (
 
 // Client represents a BingX API client.
 type Client struct {
-	apiKey     string
-	apiSecret  string
-	baseURL    string
-	httpClient *http.Client
+	apiKey      string
+	apiSecret   string
+	baseURL     string
+	httpClient  *http.Client
+	rateLimiter RateLimiter
+	retryPolicy RetryPolicy
+
+	symbolsOnce sync.Once
+	symbols     *SymbolRegistry
 }
 
 // Config holds configuration for creating a new Client.
 type Config struct {
-	APIKey     string
-	APISecret  string
-	BaseURL    string       // optional, defaults to defaultBaseURL
-	HTTPClient *http.Client // optional, defaults to http.DefaultClient
+	APIKey      string
+	APISecret   string
+	BaseURL     string       // optional, defaults to defaultBaseURL
+	HTTPClient  *http.Client // optional, defaults to http.DefaultClient
+	RateLimiter RateLimiter  // optional, defaults to a TokenBucketLimiter matching BingX's per-IP limit
+	RetryPolicy *RetryPolicy // optional, defaults to DefaultRetryPolicy(); pass &RetryPolicy{} to disable retries
+}
+
+// RetryPolicy controls automatic retry of failed requests in doRequest.
+type RetryPolicy struct {
+	MaxRetries     int              // maximum retry attempts, 0 disables retries
+	InitialBackoff time.Duration    // backoff before the first retry
+	MaxBackoff     time.Duration    // backoff is capped at this value
+	Retryable      func(error) bool // reports whether err should be retried
+}
+
+// DefaultRetryPolicy retries HTTP 429/5xx responses and BingX rate-limit
+// errors up to 3 times with exponential backoff between 500ms and 5s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Retryable:      isRetryableError,
+	}
+}
+
+// isRetryableError is the default RetryPolicy.Retryable: it retries
+// transient HTTP status errors and BingX's rate-limit error code.
+func isRetryableError(err error) bool {
+	var httpErr *httpStatusError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests || httpErr.StatusCode >= 500
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return isRetryableCode(apiErr.Code)
+	}
+
+	return false
+}
+
+// httpStatusError wraps a non-200 HTTP response that isn't a BingX API error
+// envelope (e.g. a gateway timeout returning an HTML body).
+type httpStatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("bingx: request failed: status %d, body: %s", e.StatusCode, e.Body)
+}
+
+func (e *httpStatusError) Unwrap() error {
+	return ErrRequestFailed
 }
 
 // NewClient creates a new BingX API client.
@@ -61,27 +120,39 @@ func NewClient(cfg Config) (*Client, error) {
 		httpClient = http.DefaultClient
 	}
 
+	rateLimiter := cfg.RateLimiter
+	if rateLimiter == nil {
+		rateLimiter = NewTokenBucketLimiter(defaultIPRateLimit)
+	}
+
+	retryPolicy := DefaultRetryPolicy()
+	if cfg.RetryPolicy != nil {
+		retryPolicy = *cfg.RetryPolicy
+	}
+
 	return &Client{
-		apiKey:     cfg.APIKey,
-		apiSecret:  cfg.APISecret,
-		baseURL:    baseURL,
-		httpClient: httpClient,
+		apiKey:      cfg.APIKey,
+		apiSecret:   cfg.APISecret,
+		baseURL:     baseURL,
+		httpClient:  httpClient,
+		rateLimiter: rateLimiter,
+		retryPolicy: retryPolicy,
 	}, nil
 }
 
 // PlaceOrderRequest represents parameters for placing an order.
 type PlaceOrderRequest struct {
-	Symbol       string  `json:"symbol"`                 // Trading pair, e.g. "BTC-USDT"
-	Type         string  `json:"type"`                   // Order type: MARKET, LIMIT, STOP_MARKET, STOP, TAKE_PROFIT_MARKET, TAKE_PROFIT, TRIGGER_LIMIT, TRIGGER_MARKET
-	Side         string  `json:"side"`                   // Order side: BUY, SELL
-	PositionSide string  `json:"positionSide,omitempty"` // Position side: LONG, SHORT (required for hedge mode)
-	ReduceOnly   string  `json:"reduceOnly,omitempty"`   // Reduce only flag: true, false
-	Price        float64 `json:"price,omitempty"`        // Order price (required for LIMIT orders)
-	Quantity     float64 `json:"quantity,omitempty"`     // Order quantity
-	StopPrice    float64 `json:"stopPrice,omitempty"`    // Stop price for stop orders
-	PriceRate    float64 `json:"priceRate,omitempty"`    // Price rate for trailing stop orders
-	StopLoss     string  `json:"stopLoss,omitempty"`     // Stop loss parameters in JSON format
-	TakeProfit   string  `json:"takeProfit,omitempty"`   // Take profit parameters in JSON format
+	Symbol       string   `json:"symbol"`                 // Trading pair, e.g. "BTC-USDT"
+	Type         string   `json:"type"`                   // Order type: MARKET, LIMIT, STOP_MARKET, STOP, TAKE_PROFIT_MARKET, TAKE_PROFIT, TRIGGER_LIMIT, TRIGGER_MARKET
+	Side         string   `json:"side"`                   // Order side: BUY, SELL
+	PositionSide string   `json:"positionSide,omitempty"` // Position side: LONG, SHORT (required for hedge mode)
+	ReduceOnly   string   `json:"reduceOnly,omitempty"`   // Reduce only flag: true, false
+	Price        *Decimal `json:"price,omitempty"`        // Order price (required for LIMIT orders)
+	Quantity     *Decimal `json:"quantity,omitempty"`     // Order quantity
+	StopPrice    float64  `json:"stopPrice,omitempty"`    // Stop price for stop orders
+	PriceRate    float64  `json:"priceRate,omitempty"`    // Price rate for trailing stop orders
+	StopLoss     string   `json:"stopLoss,omitempty"`     // Stop loss parameters in JSON format
+	TakeProfit   string   `json:"takeProfit,omitempty"`   // Take profit parameters in JSON format
 	// WorkingType specifies the price type for triggers: MARK_PRICE (mark price), CONTRACT_PRICE (last price)
 	// MARK_PRICE is recommended to prevent manipulation
 	WorkingType     string  `json:"workingType,omitempty"`
@@ -232,6 +303,18 @@ type Contract struct {
 	DisplayName       string  `json:"displayName"`       // Display name
 }
 
+// RoundPrice truncates d to the symbol's PricePrecision, avoiding the
+// "-1106 precision invalid" rejections BingX returns for over-precise prices.
+func (c *Contract) RoundPrice(d Decimal) Decimal {
+	return d.Truncate(int32(c.PricePrecision))
+}
+
+// RoundQuantity truncates d to the symbol's QuantityPrecision, avoiding the
+// "-1106 precision invalid" rejections BingX returns for over-precise quantities.
+func (c *Contract) RoundQuantity(d Decimal) Decimal {
+	return d.Truncate(int32(c.QuantityPrecision))
+}
+
 // GetContractsResponse represents the response from getting contracts information.
 type GetContractsResponse struct {
 	Code int        `json:"code"`
@@ -309,6 +392,45 @@ func (c *Client) SetMarginType(ctx context.Context, req SetMarginTypeRequest) (*
 }
 
 func (c *Client) doRequest(ctx context.Context, method, endpoint string, params map[string]interface{}, result interface{}) error {
+	var lastErr error
+	backoff := c.retryPolicy.InitialBackoff
+
+	for attempt := 0; attempt <= c.retryPolicy.MaxRetries; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+			backoff *= 2
+			if backoff > c.retryPolicy.MaxBackoff {
+				backoff = c.retryPolicy.MaxBackoff
+			}
+		}
+
+		if err := c.rateLimiter.Wait(ctx, endpointWeight(endpoint)); err != nil {
+			return fmt.Errorf("bingx: rate limiter: %w", err)
+		}
+
+		err := c.doRequestOnce(ctx, method, endpoint, params, result)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		if c.retryPolicy.Retryable == nil || !c.retryPolicy.Retryable(err) {
+			return err
+		}
+	}
+
+	return lastErr
+}
+
+// doRequestOnce performs a single signed HTTP round trip, unmarshaling the
+// {code, msg} envelope and returning an *APIError when code != 0.
+func (c *Client) doRequestOnce(ctx context.Context, method, endpoint string, params map[string]interface{}, result interface{}) error {
 	if params == nil {
 		params = make(map[string]interface{})
 	}
@@ -346,7 +468,18 @@ func (c *Client) doRequest(ctx context.Context, method, endpoint string, params
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("%w: status %d, body: %s", ErrRequestFailed, resp.StatusCode, string(body))
+		return &httpStatusError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	var envelope struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	if envelope.Code != 0 {
+		return &APIError{Code: envelope.Code, Msg: envelope.Msg}
 	}
 
 	if err := json.Unmarshal(body, result); err != nil {
@@ -423,6 +556,13 @@ func (c *Client) formatValue(v interface{}) string {
 	case uint, uint8, uint16, uint32, uint64:
 		return fmt.Sprintf("%d", val)
 	default:
+		// A top-level array or struct param (e.g. batchOrders) must be sent
+		// as a single JSON-encoded field value, not Go's %v syntax.
+		if rv := reflect.ValueOf(val); rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array || rv.Kind() == reflect.Map || rv.Kind() == reflect.Struct {
+			if b, err := json.Marshal(val); err == nil {
+				return string(b)
+			}
+		}
 		return fmt.Sprintf("%v", val)
 	}
 }
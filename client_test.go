@@ -0,0 +1,115 @@
+package bingx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"rate limit api error", ErrCodeRateLimit, true},
+		{"order not found api error", ErrCodeOrderNotFound, false},
+		{"http 429", &httpStatusError{StatusCode: http.StatusTooManyRequests}, true},
+		{"http 503", &httpStatusError{StatusCode: http.StatusServiceUnavailable}, true},
+		{"http 400", &httpStatusError{StatusCode: http.StatusBadRequest}, false},
+		{"unrelated error", ErrInvalidResponse, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableError(tt.err); got != tt.want {
+				t.Errorf("isRetryableError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+// countingLimiter counts every Wait call, so tests can assert the rate
+// limiter is re-checked on every retry attempt, not just once up front.
+type countingLimiter struct {
+	calls int32
+}
+
+func (l *countingLimiter) Wait(ctx context.Context, weight int) error {
+	atomic.AddInt32(&l.calls, 1)
+	return nil
+}
+
+func TestDoRequestChecksRateLimiterOnEveryRetry(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		if n < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"code":0,"msg":""}`))
+	}))
+	defer srv.Close()
+
+	limiter := &countingLimiter{}
+	c, err := NewClient(Config{
+		APIKey:      "key",
+		APISecret:   "secret",
+		BaseURL:     srv.URL,
+		RateLimiter: limiter,
+		RetryPolicy: &RetryPolicy{
+			MaxRetries:     3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Millisecond,
+			Retryable:      isRetryableError,
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	var result struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	}
+	if err := c.doRequest(context.Background(), http.MethodGet, "/test", nil, &result); err != nil {
+		t.Fatalf("doRequest: %v", err)
+	}
+
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Fatalf("expected 3 HTTP attempts, got %d", got)
+	}
+	if got := atomic.LoadInt32(&limiter.calls); got != 3 {
+		t.Fatalf("expected the rate limiter to be checked on every attempt, got %d calls for 3 attempts", got)
+	}
+}
+
+func TestNewClientExplicitZeroRetriesDisablesRetries(t *testing.T) {
+	c, err := NewClient(Config{
+		APIKey:      "key",
+		APISecret:   "secret",
+		RetryPolicy: &RetryPolicy{MaxRetries: 0},
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if c.retryPolicy.MaxRetries != 0 {
+		t.Fatalf("explicit &RetryPolicy{MaxRetries: 0} was replaced by DefaultRetryPolicy(): got MaxRetries=%d", c.retryPolicy.MaxRetries)
+	}
+}
+
+func TestNewClientDefaultsRetryPolicyWhenUnset(t *testing.T) {
+	c, err := NewClient(Config{APIKey: "key", APISecret: "secret"})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+
+	if c.retryPolicy.MaxRetries != DefaultRetryPolicy().MaxRetries {
+		t.Fatalf("expected DefaultRetryPolicy to apply when Config.RetryPolicy is nil, got MaxRetries=%d", c.retryPolicy.MaxRetries)
+	}
+}
@@ -0,0 +1,21 @@
+package bingx
+
+import "github.com/shopspring/decimal"
+
+// Decimal is an arbitrary-precision decimal used for price and quantity
+// fields, replacing float64 so values round-trip through requests and
+// responses without the silent mis-rounding float64 produces for symbols
+// whose precision doesn't match formatValue's adaptive %.*f guess.
+type Decimal = decimal.Decimal
+
+// NewDecimal parses s (e.g. "0.0123") into a Decimal.
+func NewDecimal(s string) (Decimal, error) {
+	return decimal.NewFromString(s)
+}
+
+// DecimalFromFloat converts f into a Decimal. Prefer NewDecimal when the
+// value originates as a string, since float64 can't exactly represent every
+// decimal fraction.
+func DecimalFromFloat(f float64) Decimal {
+	return decimal.NewFromFloat(f)
+}
@@ -0,0 +1,49 @@
+package bingx
+
+import "fmt"
+
+// APIError represents the {code, msg} error envelope returned by BingX
+// whenever a request fails with a non-zero code. Compare against the
+// exported ErrCode* sentinels with errors.Is to handle specific failures.
+type APIError struct {
+	Code int    // BingX error code
+	Msg  string // BingX error message
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("bingx: api error %d: %s", e.Code, e.Msg)
+}
+
+// Is reports whether target is an *APIError with the same Code, so callers
+// can use errors.Is(err, bingx.ErrCodeOrderNotFound) without matching Msg.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+// Known BingX error codes, exported so callers can match specific failures
+// with errors.Is. Msg is informational; only Code is compared.
+var (
+	ErrCodeInvalidSignature    = &APIError{Code: 100413, Msg: "signature verification failed"}
+	ErrCodeInvalidTimestamp    = &APIError{Code: 100421, Msg: "timestamp expired"}
+	ErrCodeRateLimit           = &APIError{Code: 100410, Msg: "request weight limit exceeded"}
+	ErrCodeInsufficientBalance = &APIError{Code: 80001, Msg: "insufficient balance"}
+	ErrCodeOrderNotFound       = &APIError{Code: 80016, Msg: "order does not exist"}
+	ErrCodePositionNotExist    = &APIError{Code: 80017, Msg: "position does not exist"}
+)
+
+// isRetryableCode reports whether a BingX error code is transient and safe
+// to retry, as opposed to a rejection the caller must fix (bad params,
+// insufficient balance, unknown order, ...).
+func isRetryableCode(code int) bool {
+	switch code {
+	case ErrCodeRateLimit.Code:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,182 @@
+package bingx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const listenKeyEndpoint = "/openApi/user/auth/userDataStream"
+
+// listenKeyRefreshInterval is how often ListenKeyManager.Run refreshes the
+// listen key, well inside BingX's ~60 minute expiry.
+const listenKeyRefreshInterval = 25 * time.Minute
+
+// CreateListenKeyResponse represents the response from CreateListenKey.
+type CreateListenKeyResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data struct {
+		ListenKey string `json:"listenKey"`
+	} `json:"data"`
+}
+
+// CreateListenKey obtains a new listen key for the private user-data stream.
+func (c *Client) CreateListenKey(ctx context.Context) (string, error) {
+	var resp CreateListenKeyResponse
+	if err := c.doRequest(ctx, http.MethodPost, listenKeyEndpoint, nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.Data.ListenKey, nil
+}
+
+// KeepAliveListenKey extends a listen key's expiry. BingX requires this
+// roughly every 30 minutes or the key (and its user-data stream) expires.
+func (c *Client) KeepAliveListenKey(ctx context.Context, listenKey string) error {
+	params := map[string]interface{}{"listenKey": listenKey}
+	var resp struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	}
+	return c.doRequest(ctx, http.MethodPut, listenKeyEndpoint, params, &resp)
+}
+
+// CloseListenKey invalidates a listen key, closing its user-data stream.
+func (c *Client) CloseListenKey(ctx context.Context, listenKey string) error {
+	params := map[string]interface{}{"listenKey": listenKey}
+	var resp struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	}
+	return c.doRequest(ctx, http.MethodDelete, listenKeyEndpoint, params, &resp)
+}
+
+// KeyRotated is emitted on ListenKeyManager's event channel whenever a
+// keepalive fails and Run obtains a replacement listen key. Callers should
+// use NewKey to reconnect their ws.PrivateClient.
+type KeyRotated struct {
+	OldKey string
+	NewKey string
+	Err    error // the keepalive failure that triggered the rotation
+}
+
+// ListenKeyManager owns the lifecycle of a single user-data stream listen
+// key: obtaining it, keeping it alive on a schedule, and rotating it if a
+// keepalive fails.
+type ListenKeyManager struct {
+	client *Client
+
+	mu      sync.Mutex
+	key     string
+	events  chan KeyRotated
+	closeCh chan struct{}
+	closed  bool
+	wg      sync.WaitGroup
+}
+
+// NewListenKeyManager creates a manager backed by client.
+func NewListenKeyManager(client *Client) *ListenKeyManager {
+	return &ListenKeyManager{
+		client:  client,
+		events:  make(chan KeyRotated, 1),
+		closeCh: make(chan struct{}),
+	}
+}
+
+// Start obtains a new listen key and remembers it as the manager's current key.
+func (m *ListenKeyManager) Start(ctx context.Context) (string, error) {
+	key, err := m.client.CreateListenKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	m.mu.Lock()
+	m.key = key
+	m.mu.Unlock()
+
+	return key, nil
+}
+
+// Keepalive extends key's expiry.
+func (m *ListenKeyManager) Keepalive(ctx context.Context, key string) error {
+	return m.client.KeepAliveListenKey(ctx, key)
+}
+
+// Close invalidates key.
+func (m *ListenKeyManager) Close(ctx context.Context, key string) error {
+	return m.client.CloseListenKey(ctx, key)
+}
+
+// Events returns the channel KeyRotated notifications are sent on.
+func (m *ListenKeyManager) Events() <-chan KeyRotated {
+	return m.events
+}
+
+// Run launches a goroutine that keeps the current listen key alive every
+// listenKeyRefreshInterval. If a keepalive fails, it obtains a new listen
+// key and emits a KeyRotated event so the ws subsystem can reconnect. Run
+// returns immediately; it stops when ctx is done or Stop is called.
+func (m *ListenKeyManager) Run(ctx context.Context) {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		ticker := time.NewTicker(listenKeyRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-m.closeCh:
+				return
+			case <-ticker.C:
+				m.refresh(ctx)
+			}
+		}
+	}()
+}
+
+func (m *ListenKeyManager) refresh(ctx context.Context) {
+	m.mu.Lock()
+	key := m.key
+	m.mu.Unlock()
+
+	keepaliveErr := m.Keepalive(ctx, key)
+	if keepaliveErr == nil || ctx.Err() != nil {
+		return
+	}
+
+	newKey, startErr := m.Start(ctx)
+	event := KeyRotated{
+		OldKey: key,
+		NewKey: newKey,
+		Err:    fmt.Errorf("bingx: listen key keepalive failed, rotated: %w", keepaliveErr),
+	}
+	if startErr != nil {
+		event.Err = fmt.Errorf("bingx: listen key keepalive failed (%v) and rotation also failed: %w", keepaliveErr, startErr)
+	}
+
+	select {
+	case m.events <- event:
+	default:
+	}
+}
+
+// Stop stops the goroutine started by Run. It does not close the listen key
+// on BingX; call Close explicitly if the stream should be torn down too.
+// It is safe to call more than once.
+func (m *ListenKeyManager) Stop() {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return
+	}
+	m.closed = true
+	m.mu.Unlock()
+
+	close(m.closeCh)
+	m.wg.Wait()
+}
@@ -0,0 +1,515 @@
+package bingx
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// AccountBalance represents a single asset balance on the perpetual swap account.
+type AccountBalance struct {
+	Asset            string `json:"asset"`            // Asset, e.g. "USDT"
+	Balance          string `json:"balance"`          // Total balance
+	Equity           string `json:"equity"`           // Account equity including unrealized PnL
+	UnrealizedProfit string `json:"unrealizedProfit"` // Unrealized PnL
+	AvailableMargin  string `json:"availableMargin"`  // Available margin
+	UsedMargin       string `json:"usedMargin"`       // Margin currently in use
+}
+
+// GetBalanceResponse represents the response from GetBalance.
+type GetBalanceResponse struct {
+	Code int              `json:"code"`
+	Msg  string           `json:"msg"`
+	Data []AccountBalance `json:"data"`
+}
+
+// GetBalance retrieves the perpetual swap account's asset balances.
+func (c *Client) GetBalance(ctx context.Context) (*GetBalanceResponse, error) {
+	var resp GetBalanceResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/openApi/swap/v2/user/balance", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Position represents an open position.
+type Position struct {
+	Symbol           string `json:"symbol"`           // Trading pair, e.g. "BTC-USDT"
+	PositionID       string `json:"positionId"`       // Position ID
+	PositionSide     string `json:"positionSide"`     // Position side: LONG, SHORT
+	PositionAmt      string `json:"positionAmt"`      // Position quantity
+	AvailableAmt     string `json:"availableAmt"`     // Quantity available to close
+	EntryPrice       string `json:"entryPrice"`       // Average entry price
+	MarkPrice        string `json:"markPrice"`        // Current mark price
+	UnrealizedProfit string `json:"unrealizedProfit"` // Unrealized PnL
+	Leverage         int    `json:"leverage"`         // Current leverage
+	MarginType       string `json:"marginType"`       // CROSSED or ISOLATED
+}
+
+// GetPositionsRequest represents parameters for GetPositions.
+type GetPositionsRequest struct {
+	Symbol string `json:"symbol,omitempty"` // optional, returns all symbols when empty
+}
+
+// GetPositionsResponse represents the response from GetPositions.
+type GetPositionsResponse struct {
+	Code int        `json:"code"`
+	Msg  string     `json:"msg"`
+	Data []Position `json:"data"`
+}
+
+// GetPositions retrieves open positions, optionally filtered by symbol.
+func (c *Client) GetPositions(ctx context.Context, req GetPositionsRequest) (*GetPositionsResponse, error) {
+	params, err := structToMap(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp GetPositionsResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/openApi/swap/v2/user/positions", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Order represents an order as returned by the open-orders and order-history endpoints.
+type Order struct {
+	Symbol        string `json:"symbol"`        // Trading pair, e.g. "BTC-USDT"
+	OrderID       string `json:"orderId"`       // Order ID
+	ClientOrderID string `json:"clientOrderId"` // Client order ID
+	Side          string `json:"side"`          // Order side: BUY, SELL
+	PositionSide  string `json:"positionSide"`  // Position side: LONG, SHORT
+	Type          string `json:"type"`          // Order type
+	Status        string `json:"status"`        // Order status
+	Price         string `json:"price"`         // Order price
+	Quantity      string `json:"quantity"`      // Order quantity
+	ExecutedQty   string `json:"executedQty"`   // Executed quantity
+	AvgPrice      string `json:"avgPrice"`      // Average execution price
+	Time          int64  `json:"time"`          // Creation timestamp in milliseconds
+	UpdateTime    int64  `json:"updateTime"`    // Last update timestamp in milliseconds
+}
+
+// GetOpenOrdersRequest represents parameters for GetOpenOrders.
+type GetOpenOrdersRequest struct {
+	Symbol string `json:"symbol,omitempty"` // optional, returns all symbols when empty
+}
+
+// GetOpenOrdersResponse represents the response from GetOpenOrders.
+type GetOpenOrdersResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data struct {
+		Orders []Order `json:"orders"`
+	} `json:"data"`
+}
+
+// GetOpenOrders retrieves currently open orders, optionally filtered by symbol.
+func (c *Client) GetOpenOrders(ctx context.Context, req GetOpenOrdersRequest) (*GetOpenOrdersResponse, error) {
+	params, err := structToMap(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp GetOpenOrdersResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/openApi/swap/v2/trade/openOrders", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetOrderRequest represents parameters for GetOrder. Either OrderID or
+// ClientOrderID must be set.
+type GetOrderRequest struct {
+	Symbol        string `json:"symbol"`                  // Trading pair, e.g. "BTC-USDT"
+	OrderID       string `json:"orderId,omitempty"`       // Order ID
+	ClientOrderID string `json:"clientOrderId,omitempty"` // Client order ID
+}
+
+// GetOrderResponse represents the response from GetOrder.
+type GetOrderResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data Order  `json:"data"`
+}
+
+// GetOrder retrieves a single order by OrderID or ClientOrderID.
+func (c *Client) GetOrder(ctx context.Context, req GetOrderRequest) (*GetOrderResponse, error) {
+	params, err := structToMap(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp GetOrderResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/openApi/swap/v2/trade/order", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// maxHistoryLimit is the largest page size BingX accepts for the
+// order/trade/kline history endpoints.
+const maxHistoryLimit = 1000
+
+// GetOrderHistoryRequest represents parameters for GetOrderHistory.
+type GetOrderHistoryRequest struct {
+	Symbol    string `json:"symbol"`              // Trading pair, e.g. "BTC-USDT"
+	StartTime int64  `json:"startTime,omitempty"` // Start timestamp in milliseconds
+	EndTime   int64  `json:"endTime,omitempty"`   // End timestamp in milliseconds
+	Limit     int    `json:"limit,omitempty"`     // Page size, max 1000
+}
+
+// GetOrderHistoryResponse represents the response from GetOrderHistory.
+type GetOrderHistoryResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data struct {
+		Orders []Order `json:"orders"`
+	} `json:"data"`
+}
+
+// GetOrderHistory retrieves historical (filled, canceled, or expired) orders
+// for symbol within [startTime, endTime]. Use GetOrderHistoryAll to page
+// through results beyond a single call's limit.
+func (c *Client) GetOrderHistory(ctx context.Context, req GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
+	params, err := structToMap(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp GetOrderHistoryResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/openApi/swap/v2/trade/allOrders", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetOrderHistoryAll pages through GetOrderHistory for the full
+// [startTime, endTime] range, advancing the window past the last order's
+// Time on each page until fewer than maxHistoryLimit orders are returned.
+func (c *Client) GetOrderHistoryAll(ctx context.Context, symbol string, startTime, endTime int64) ([]Order, error) {
+	var all []Order
+	for {
+		resp, err := c.GetOrderHistory(ctx, GetOrderHistoryRequest{
+			Symbol:    symbol,
+			StartTime: startTime,
+			EndTime:   endTime,
+			Limit:     maxHistoryLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, resp.Data.Orders...)
+		if len(resp.Data.Orders) < maxHistoryLimit {
+			return all, nil
+		}
+
+		last := resp.Data.Orders[len(resp.Data.Orders)-1]
+		startTime = last.Time + 1
+	}
+}
+
+// Trade represents a single fill from the trade history.
+type Trade struct {
+	Symbol      string `json:"symbol"`      // Trading pair, e.g. "BTC-USDT"
+	OrderID     string `json:"orderId"`     // Order ID the fill belongs to
+	Side        string `json:"side"`        // Order side: BUY, SELL
+	Price       string `json:"price"`       // Fill price
+	Quantity    string `json:"qty"`         // Fill quantity
+	Commission  string `json:"commission"`  // Commission charged
+	RealizedPnl string `json:"realizedPnl"` // Realized PnL from this fill
+	Time        int64  `json:"time"`        // Fill timestamp in milliseconds
+}
+
+// GetTradeHistoryRequest represents parameters for GetTradeHistory.
+type GetTradeHistoryRequest struct {
+	Symbol    string `json:"symbol"`              // Trading pair, e.g. "BTC-USDT"
+	StartTime int64  `json:"startTime,omitempty"` // Start timestamp in milliseconds
+	EndTime   int64  `json:"endTime,omitempty"`   // End timestamp in milliseconds
+	Limit     int    `json:"limit,omitempty"`     // Page size, max 1000
+}
+
+// GetTradeHistoryResponse represents the response from GetTradeHistory.
+type GetTradeHistoryResponse struct {
+	Code int     `json:"code"`
+	Msg  string  `json:"msg"`
+	Data []Trade `json:"data"`
+}
+
+// GetTradeHistory retrieves the account's fill history for symbol.
+func (c *Client) GetTradeHistory(ctx context.Context, req GetTradeHistoryRequest) (*GetTradeHistoryResponse, error) {
+	params, err := structToMap(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp GetTradeHistoryResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/openApi/swap/v2/trade/allFillOrders", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Income represents a single funding fee, commission, or realized PnL entry.
+type Income struct {
+	Symbol     string `json:"symbol"`     // Trading pair, e.g. "BTC-USDT"
+	IncomeType string `json:"incomeType"` // FUNDING_FEE, COMMISSION, REALIZED_PNL, ...
+	Income     string `json:"income"`     // Income amount, negative for fees paid
+	Asset      string `json:"asset"`      // Settlement asset, e.g. "USDT"
+	Info       string `json:"info"`       // Human-readable description
+	Time       int64  `json:"time"`       // Timestamp in milliseconds
+}
+
+// GetIncomeHistoryRequest represents parameters for GetIncomeHistory.
+type GetIncomeHistoryRequest struct {
+	Symbol     string `json:"symbol,omitempty"`     // optional, returns all symbols when empty
+	IncomeType string `json:"incomeType,omitempty"` // optional filter: FUNDING_FEE, COMMISSION, REALIZED_PNL, ...
+	StartTime  int64  `json:"startTime,omitempty"`  // Start timestamp in milliseconds
+	EndTime    int64  `json:"endTime,omitempty"`    // End timestamp in milliseconds
+	Limit      int    `json:"limit,omitempty"`      // Page size, max 1000
+}
+
+// GetIncomeHistoryResponse represents the response from GetIncomeHistory.
+type GetIncomeHistoryResponse struct {
+	Code int      `json:"code"`
+	Msg  string   `json:"msg"`
+	Data []Income `json:"data"`
+}
+
+// GetIncomeHistory retrieves funding fees, commissions, and realized PnL entries.
+func (c *Client) GetIncomeHistory(ctx context.Context, req GetIncomeHistoryRequest) (*GetIncomeHistoryResponse, error) {
+	params, err := structToMap(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp GetIncomeHistoryResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/openApi/swap/v2/user/income", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetKlinesRequest represents parameters for GetKlines.
+type GetKlinesRequest struct {
+	Symbol    string `json:"symbol"`              // Trading pair, e.g. "BTC-USDT"
+	Interval  string `json:"interval"`            // Candle interval, e.g. "1m", "5m", "1h", "1d"
+	StartTime int64  `json:"startTime,omitempty"` // Start timestamp in milliseconds
+	EndTime   int64  `json:"endTime,omitempty"`   // End timestamp in milliseconds
+	Limit     int    `json:"limit,omitempty"`     // Page size, max 1000
+}
+
+// GetKlinesResponse represents the response from GetKlines.
+type GetKlinesResponse struct {
+	Code int     `json:"code"`
+	Msg  string  `json:"msg"`
+	Data []Kline `json:"data"`
+}
+
+// GetKlines retrieves historical candlesticks for symbol at interval.
+// Use GetKlinesAll to page through results beyond a single call's limit.
+func (c *Client) GetKlines(ctx context.Context, req GetKlinesRequest) (*GetKlinesResponse, error) {
+	params, err := structToMap(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp GetKlinesResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/openApi/swap/v3/quote/klines", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetKlinesAll pages through GetKlines for the full [startTime, endTime]
+// range, advancing the window past the last candle's CloseTime on each page
+// until fewer than maxHistoryLimit candles are returned.
+func (c *Client) GetKlinesAll(ctx context.Context, symbol, interval string, startTime, endTime int64) ([]Kline, error) {
+	var all []Kline
+	for {
+		resp, err := c.GetKlines(ctx, GetKlinesRequest{
+			Symbol:    symbol,
+			Interval:  interval,
+			StartTime: startTime,
+			EndTime:   endTime,
+			Limit:     maxHistoryLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, resp.Data...)
+		if len(resp.Data) < maxHistoryLimit {
+			return all, nil
+		}
+
+		last := resp.Data[len(resp.Data)-1]
+		startTime = last.CloseTime + 1
+	}
+}
+
+// DepthLevel is a single [price, quantity] level in an order book snapshot.
+type DepthLevel struct {
+	Price    string
+	Quantity string
+}
+
+// MarshalJSON encodes a DepthLevel as the ["price", "quantity"] pair BingX returns.
+func (l DepthLevel) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]string{l.Price, l.Quantity})
+}
+
+// UnmarshalJSON decodes a ["price", "quantity"] pair into a DepthLevel.
+func (l *DepthLevel) UnmarshalJSON(data []byte) error {
+	var pair [2]string
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+	l.Price, l.Quantity = pair[0], pair[1]
+	return nil
+}
+
+// GetDepthRequest represents parameters for GetDepth.
+type GetDepthRequest struct {
+	Symbol string `json:"symbol"`          // Trading pair, e.g. "BTC-USDT"
+	Limit  int    `json:"limit,omitempty"` // Number of levels per side, max 1000
+}
+
+// GetDepthResponse represents the response from GetDepth.
+type GetDepthResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data struct {
+		Bids []DepthLevel `json:"bids"`
+		Asks []DepthLevel `json:"asks"`
+		Time int64        `json:"T"`
+	} `json:"data"`
+}
+
+// GetDepth retrieves an order book snapshot for symbol.
+func (c *Client) GetDepth(ctx context.Context, req GetDepthRequest) (*GetDepthResponse, error) {
+	params, err := structToMap(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp GetDepthResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/openApi/swap/v2/quote/depth", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// FundingRate represents a symbol's current or historical funding rate.
+type FundingRate struct {
+	Symbol      string `json:"symbol"`      // Trading pair, e.g. "BTC-USDT"
+	FundingRate string `json:"fundingRate"` // Funding rate for the period
+	FundingTime int64  `json:"fundingTime"` // Funding settlement timestamp in milliseconds
+}
+
+// GetFundingRateResponse represents the response from GetFundingRate.
+type GetFundingRateResponse struct {
+	Code int           `json:"code"`
+	Msg  string        `json:"msg"`
+	Data []FundingRate `json:"data"`
+}
+
+// GetFundingRate retrieves the current funding rate for symbol. Unlike
+// GetFundingRateHistory, this reads the lastFundingRate BingX reports on the
+// premium-index endpoint (the same one GetMarkPrice uses), since
+// /openApi/swap/v2/quote/fundingRate is the history endpoint and returns
+// settled periods, not the current rate.
+func (c *Client) GetFundingRate(ctx context.Context, symbol string) (*GetFundingRateResponse, error) {
+	markResp, err := c.GetMarkPrice(ctx, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &GetFundingRateResponse{Code: markResp.Code, Msg: markResp.Msg}
+	for _, m := range markResp.Data {
+		resp.Data = append(resp.Data, FundingRate{
+			Symbol:      m.Symbol,
+			FundingRate: m.LastFundingRate,
+			FundingTime: m.Time,
+		})
+	}
+	return resp, nil
+}
+
+// GetFundingRateHistoryRequest represents parameters for GetFundingRateHistory.
+type GetFundingRateHistoryRequest struct {
+	Symbol    string `json:"symbol"`              // Trading pair, e.g. "BTC-USDT"
+	StartTime int64  `json:"startTime,omitempty"` // Start timestamp in milliseconds
+	EndTime   int64  `json:"endTime,omitempty"`   // End timestamp in milliseconds
+	Limit     int    `json:"limit,omitempty"`     // Page size, max 1000
+}
+
+// GetFundingRateHistoryResponse represents the response from GetFundingRateHistory.
+type GetFundingRateHistoryResponse struct {
+	Code int           `json:"code"`
+	Msg  string        `json:"msg"`
+	Data []FundingRate `json:"data"`
+}
+
+// GetFundingRateHistory retrieves historical funding rates for symbol.
+func (c *Client) GetFundingRateHistory(ctx context.Context, req GetFundingRateHistoryRequest) (*GetFundingRateHistoryResponse, error) {
+	params, err := structToMap(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp GetFundingRateHistoryResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/openApi/swap/v2/quote/fundingRate", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// MarkPriceInfo represents a symbol's current mark price.
+type MarkPriceInfo struct {
+	Symbol          string `json:"symbol"`          // Trading pair, e.g. "BTC-USDT"
+	MarkPrice       string `json:"markPrice"`       // Current mark price
+	LastFundingRate string `json:"lastFundingRate"` // Most recently settled funding rate
+	Time            int64  `json:"time"`            // Timestamp in milliseconds
+}
+
+// GetMarkPriceResponse represents the response from GetMarkPrice.
+type GetMarkPriceResponse struct {
+	Code int             `json:"code"`
+	Msg  string          `json:"msg"`
+	Data []MarkPriceInfo `json:"data"`
+}
+
+// GetMarkPrice retrieves the current mark price for symbol, or all symbols
+// when symbol is empty.
+func (c *Client) GetMarkPrice(ctx context.Context, symbol string) (*GetMarkPriceResponse, error) {
+	params := map[string]interface{}{}
+	if symbol != "" {
+		params["symbol"] = symbol
+	}
+
+	var resp GetMarkPriceResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/openApi/swap/v2/quote/premiumIndex", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetServerTimeResponse represents the response from GetServerTime.
+type GetServerTimeResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data struct {
+		ServerTime int64 `json:"serverTime"`
+	} `json:"data"`
+}
+
+// GetServerTime retrieves BingX's current server time, useful for detecting
+// clock drift before it causes timestamp-related request rejections.
+func (c *Client) GetServerTime(ctx context.Context) (*GetServerTimeResponse, error) {
+	var resp GetServerTimeResponse
+	if err := c.doRequest(ctx, http.MethodGet, "/openApi/swap/v2/server/time", nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
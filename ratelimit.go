@@ -0,0 +1,91 @@
+package bingx
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter throttles outgoing requests before they are sent. Wait should
+// block until weight units of capacity are available or ctx is done.
+type RateLimiter interface {
+	Wait(ctx context.Context, weight int) error
+}
+
+// TokenBucketLimiter is the default RateLimiter, refilling ratePerMinute
+// tokens continuously and allowing bursts up to that same capacity. It
+// approximates BingX's per-minute IP and per-UID request-weight limits.
+type TokenBucketLimiter struct {
+	capacity float64
+	refill   float64 // tokens per second
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucketLimiter creates a limiter that allows up to ratePerMinute
+// weighted requests per minute, refilled continuously.
+func NewTokenBucketLimiter(ratePerMinute int) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		capacity: float64(ratePerMinute),
+		refill:   float64(ratePerMinute) / 60,
+		tokens:   float64(ratePerMinute),
+		last:     time.Now(),
+	}
+}
+
+// Wait blocks until weight tokens are available or ctx is done.
+func (l *TokenBucketLimiter) Wait(ctx context.Context, weight int) error {
+	for {
+		wait, ok := l.tryTake(float64(weight))
+		if ok {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// tryTake reports whether weight tokens were taken, or if not, how long to
+// wait before trying again.
+func (l *TokenBucketLimiter) tryTake(weight float64) (wait time.Duration, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.refill
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+	l.last = now
+
+	if l.tokens >= weight {
+		l.tokens -= weight
+		return 0, true
+	}
+
+	deficit := weight - l.tokens
+	return time.Duration(deficit/l.refill*float64(time.Second)) + time.Millisecond,
+		false
+}
+
+// defaultIPRateLimit mirrors BingX's default per-IP request-weight budget.
+const defaultIPRateLimit = 1200
+
+// endpointWeight returns the request weight BingX assigns to endpoint, used
+// to charge the rate limiter proportionally. Endpoints not listed cost 1.
+func endpointWeight(endpoint string) int {
+	switch endpoint {
+	case "/openApi/swap/v2/quote/contracts", "/openApi/swap/v2/trade/batchOrders":
+		return 5
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,59 @@
+package bingx
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketLimiterTryTake(t *testing.T) {
+	l := NewTokenBucketLimiter(60) // 1 token/sec, capacity 60
+
+	if wait, ok := l.tryTake(60); !ok || wait != 0 {
+		t.Fatalf("first take of full capacity: got (%v, %v), want (0, true)", wait, ok)
+	}
+
+	if wait, ok := l.tryTake(1); ok {
+		t.Fatalf("take immediately after draining: got (%v, %v), want ok=false", wait, ok)
+	} else if wait <= 0 {
+		t.Fatalf("expected a positive wait duration, got %v", wait)
+	}
+}
+
+func TestTokenBucketLimiterRefill(t *testing.T) {
+	l := NewTokenBucketLimiter(60)
+	if _, ok := l.tryTake(60); !ok {
+		t.Fatal("expected to drain the full bucket")
+	}
+
+	l.last = l.last.Add(-2 * time.Second) // simulate 2 seconds of elapsed refill
+	if _, ok := l.tryTake(2); !ok {
+		t.Fatal("expected 2 tokens to be available after 2 seconds of refill")
+	}
+}
+
+func TestTokenBucketLimiterCapsAtCapacity(t *testing.T) {
+	l := NewTokenBucketLimiter(60)
+	l.last = l.last.Add(-time.Hour) // far more elapsed time than capacity allows
+
+	if wait, ok := l.tryTake(61); ok || wait == 0 {
+		t.Fatalf("refill must not exceed capacity: got (%v, %v)", wait, ok)
+	}
+	if _, ok := l.tryTake(60); !ok {
+		t.Fatal("expected refill to be capped at capacity, not unbounded")
+	}
+}
+
+func TestTokenBucketLimiterWaitRespectsContext(t *testing.T) {
+	l := NewTokenBucketLimiter(60)
+	if _, ok := l.tryTake(60); !ok {
+		t.Fatal("expected to drain the full bucket")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.Wait(ctx, 1); err == nil {
+		t.Fatal("expected Wait to return an error once ctx is done")
+	}
+}
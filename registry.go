@@ -0,0 +1,147 @@
+package bingx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrBelowMinimum is returned by RoundOrder when an order's quantity or
+// notional value falls below the symbol's exchange-enforced minimum.
+var ErrBelowMinimum = errors.New("bingx: order is below the symbol's minimum quantity or value")
+
+// ErrUnknownSymbol is returned when a symbol isn't present in the SymbolRegistry.
+var ErrUnknownSymbol = errors.New("bingx: unknown symbol")
+
+// SymbolRegistry caches per-symbol Contract metadata (precision, minimums)
+// so callers can round and validate orders without an API call per order.
+type SymbolRegistry struct {
+	mu        sync.RWMutex
+	contracts map[string]Contract
+}
+
+// Contract returns the cached contract for symbol, if present.
+func (r *SymbolRegistry) Contract(symbol string) (Contract, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.contracts[symbol]
+	return c, ok
+}
+
+func (r *SymbolRegistry) replace(contracts []Contract) {
+	m := make(map[string]Contract, len(contracts))
+	for _, c := range contracts {
+		m[c.Symbol] = c
+	}
+
+	r.mu.Lock()
+	r.contracts = m
+	r.mu.Unlock()
+}
+
+// SymbolRegistry returns the Client's cached SymbolRegistry, populating it
+// with a GetContracts call on first use.
+func (c *Client) SymbolRegistry(ctx context.Context) (*SymbolRegistry, error) {
+	c.symbolsOnce.Do(func() {
+		c.symbols = &SymbolRegistry{}
+	})
+
+	c.symbols.mu.RLock()
+	empty := c.symbols.contracts == nil
+	c.symbols.mu.RUnlock()
+	if !empty {
+		return c.symbols, nil
+	}
+
+	resp, err := c.GetContracts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("bingx: failed to populate symbol registry: %w", err)
+	}
+	c.symbols.replace(resp.Data)
+
+	return c.symbols, nil
+}
+
+// checkMinimum rejects quantity (and, when price is non-nil, the resulting
+// notional) that falls short of the contract's exchange-enforced minimums.
+func (contract Contract) checkMinimum(quantity Decimal, price *Decimal) error {
+	minQty := DecimalFromFloat(contract.TradeMinQuantity)
+	if quantity.LessThan(minQty) {
+		return fmt.Errorf("%w: quantity %s below minimum %s", ErrBelowMinimum, quantity, minQty)
+	}
+
+	if price != nil {
+		notional := quantity.Mul(*price)
+		minUSDT := DecimalFromFloat(contract.TradeMinUSDT)
+		if notional.LessThan(minUSDT) {
+			return fmt.Errorf("%w: notional %s below minimum %s", ErrBelowMinimum, notional, minUSDT)
+		}
+	}
+
+	return nil
+}
+
+// RoundOrder rounds req's Price and Quantity to the symbol's precision and
+// rejects the order with ErrBelowMinimum if it falls short of the symbol's
+// TradeMinQuantity or TradeMinUSDT. It populates the Client's SymbolRegistry
+// via GetContracts on first use.
+func (c *Client) RoundOrder(ctx context.Context, req *PlaceOrderRequest) error {
+	registry, err := c.SymbolRegistry(ctx)
+	if err != nil {
+		return err
+	}
+
+	contract, ok := registry.Contract(req.Symbol)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownSymbol, req.Symbol)
+	}
+
+	if req.Price != nil {
+		rounded := contract.RoundPrice(*req.Price)
+		req.Price = &rounded
+	}
+
+	if req.Quantity != nil {
+		rounded := contract.RoundQuantity(*req.Quantity)
+		req.Quantity = &rounded
+
+		if err := contract.checkMinimum(rounded, req.Price); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RoundAmendOrder rounds req's Price and Quantity (whichever are set) to the
+// symbol's precision, the same way RoundOrder does for PlaceOrderRequest,
+// and rejects the amendment with ErrBelowMinimum if the resulting quantity
+// or notional falls short of the symbol's minimums.
+func (c *Client) RoundAmendOrder(ctx context.Context, req *AmendOrderRequest) error {
+	registry, err := c.SymbolRegistry(ctx)
+	if err != nil {
+		return err
+	}
+
+	contract, ok := registry.Contract(req.Symbol)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownSymbol, req.Symbol)
+	}
+
+	if req.Price != nil {
+		rounded := contract.RoundPrice(*req.Price)
+		req.Price = &rounded
+	}
+
+	if req.Quantity != nil {
+		rounded := contract.RoundQuantity(*req.Quantity)
+		req.Quantity = &rounded
+
+		if err := contract.checkMinimum(rounded, req.Price); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package bingx
+
+import (
+	"errors"
+	"testing"
+)
+
+func testContract() Contract {
+	return Contract{
+		Symbol:            "BTC-USDT",
+		PricePrecision:    1,
+		QuantityPrecision: 3,
+		TradeMinQuantity:  0.001,
+		TradeMinUSDT:      5,
+	}
+}
+
+func TestContractRoundPrice(t *testing.T) {
+	c := testContract()
+	got := c.RoundPrice(DecimalFromFloat(27123.456))
+	want := DecimalFromFloat(27123.4)
+	if !got.Equal(want) {
+		t.Errorf("RoundPrice(27123.456) = %s, want %s", got, want)
+	}
+}
+
+func TestContractRoundQuantity(t *testing.T) {
+	c := testContract()
+	got := c.RoundQuantity(DecimalFromFloat(0.123456))
+	want := DecimalFromFloat(0.123)
+	if !got.Equal(want) {
+		t.Errorf("RoundQuantity(0.123456) = %s, want %s", got, want)
+	}
+}
+
+func TestContractCheckMinimumRejectsBelowMinQuantity(t *testing.T) {
+	c := testContract()
+	err := c.checkMinimum(DecimalFromFloat(0.0001), nil)
+	if err == nil {
+		t.Fatal("expected an error for quantity below TradeMinQuantity")
+	}
+	if !errors.Is(err, ErrBelowMinimum) {
+		t.Errorf("expected error to wrap ErrBelowMinimum, got %v", err)
+	}
+}
+
+func TestContractCheckMinimumRejectsBelowMinNotional(t *testing.T) {
+	c := testContract()
+	price := DecimalFromFloat(100)
+	err := c.checkMinimum(DecimalFromFloat(0.01), &price) // notional = 1, below TradeMinUSDT of 5
+	if err == nil {
+		t.Fatal("expected an error for notional below TradeMinUSDT")
+	}
+	if !errors.Is(err, ErrBelowMinimum) {
+		t.Errorf("expected error to wrap ErrBelowMinimum, got %v", err)
+	}
+}
+
+func TestContractCheckMinimumAccepts(t *testing.T) {
+	c := testContract()
+	price := DecimalFromFloat(100)
+	if err := c.checkMinimum(DecimalFromFloat(1), &price); err != nil {
+		t.Errorf("expected a valid order to pass, got %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+package bingx
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// maxBatchOrders is the most orders BingX accepts in a single batchOrders call.
+const maxBatchOrders = 5
+
+// BatchOrderResult is a single successfully placed leg of a batch order call.
+type BatchOrderResult struct {
+	Symbol        string `json:"symbol"`        // Trading pair, e.g. "BTC-USDT"
+	OrderID       string `json:"orderId"`       // Order ID
+	ClientOrderID string `json:"clientOrderId"` // Client order ID
+	Status        string `json:"status"`        // Order status
+}
+
+// BatchOrderError is a single failed leg of a batch order call, identified
+// by its index into the PlaceOrderRequest slice passed to PlaceBatchOrders.
+type BatchOrderError struct {
+	Index int    `json:"index"` // Index into the submitted orders slice
+	Code  int    `json:"code"`  // BingX error code for this leg
+	Msg   string `json:"msg"`   // BingX error message for this leg
+}
+
+// BatchOrderResponse represents the response from PlaceBatchOrders.
+type BatchOrderResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data struct {
+		Orders []BatchOrderResult `json:"orders"`
+		Fail   []BatchOrderError  `json:"fail"`
+	} `json:"data"`
+}
+
+// FailedRequests returns the subset of orders whose corresponding leg failed,
+// so callers can retry only those instead of the whole batch.
+func (resp *BatchOrderResponse) FailedRequests(orders []PlaceOrderRequest) []PlaceOrderRequest {
+	failed := make([]PlaceOrderRequest, 0, len(resp.Data.Fail))
+	for _, e := range resp.Data.Fail {
+		if e.Index >= 0 && e.Index < len(orders) {
+			failed = append(failed, orders[e.Index])
+		}
+	}
+	return failed
+}
+
+// PlaceBatchOrders places up to 5 orders in a single call. The orders are
+// JSON-encoded as the top-level "batchOrders" array parameter; see
+// formatValue in client.go for how a raw slice param is serialized.
+func (c *Client) PlaceBatchOrders(ctx context.Context, orders []PlaceOrderRequest) (*BatchOrderResponse, error) {
+	if len(orders) == 0 || len(orders) > maxBatchOrders {
+		return nil, fmt.Errorf("bingx: PlaceBatchOrders accepts 1-%d orders, got %d", maxBatchOrders, len(orders))
+	}
+
+	params := map[string]interface{}{"batchOrders": orders}
+
+	var resp BatchOrderResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/openApi/swap/v2/trade/batchOrders", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// AmendOrderRequest represents parameters for AmendOrder. Either OrderID or
+// ClientOrderID must identify the order to amend.
+type AmendOrderRequest struct {
+	Symbol        string   `json:"symbol"`                  // Trading pair, e.g. "BTC-USDT"
+	OrderID       string   `json:"orderId,omitempty"`       // Order ID
+	ClientOrderID string   `json:"clientOrderId,omitempty"` // Client order ID
+	Price         *Decimal `json:"price,omitempty"`         // New order price
+	Quantity      *Decimal `json:"quantity,omitempty"`      // New order quantity
+}
+
+// AmendOrderResponse represents the response from AmendOrder.
+type AmendOrderResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data Order  `json:"data"`
+}
+
+// AmendOrder amends the price and/or quantity of an open order in place,
+// preserving its queue position where BingX's matching engine allows it.
+func (c *Client) AmendOrder(ctx context.Context, req AmendOrderRequest) (*AmendOrderResponse, error) {
+	params, err := structToMap(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert request: %w", err)
+	}
+
+	var resp AmendOrderResponse
+	if err := c.doRequest(ctx, http.MethodPut, "/openApi/swap/v2/trade/order", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CancelOrderRequest represents parameters for CancelOrder. Either OrderID
+// or ClientOrderID must identify the order to cancel.
+type CancelOrderRequest struct {
+	Symbol        string `json:"symbol"`                  // Trading pair, e.g. "BTC-USDT"
+	OrderID       string `json:"orderId,omitempty"`       // Order ID
+	ClientOrderID string `json:"clientOrderId,omitempty"` // Client order ID
+}
+
+// CancelOrderResponse represents the response from CancelOrder.
+type CancelOrderResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+	Data Order  `json:"data"`
+}
+
+// CancelOrder cancels a single open order by OrderID or ClientOrderID.
+func (c *Client) CancelOrder(ctx context.Context, req CancelOrderRequest) (*CancelOrderResponse, error) {
+	params, err := structToMap(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert request: %w", err)
+	}
+
+	var resp CancelOrderResponse
+	if err := c.doRequest(ctx, http.MethodDelete, "/openApi/swap/v2/trade/order", params, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,28 @@
+package bingx
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFormatValueEncodesSliceParamsAsJSON(t *testing.T) {
+	c := &Client{}
+
+	qty, err := NewDecimal("0.01")
+	if err != nil {
+		t.Fatalf("NewDecimal: %v", err)
+	}
+	orders := []PlaceOrderRequest{
+		{Symbol: "BTC-USDT", Side: SideBuy, Type: OrderTypeMarket, Quantity: &qty},
+	}
+
+	got := c.formatValue(orders)
+
+	var decoded []PlaceOrderRequest
+	if err := json.Unmarshal([]byte(got), &decoded); err != nil {
+		t.Fatalf("formatValue(batch orders) did not produce valid JSON: %v\ngot: %s", err, got)
+	}
+	if len(decoded) != 1 || decoded[0].Symbol != "BTC-USDT" {
+		t.Errorf("formatValue(batch orders) round-tripped incorrectly, got %s", got)
+	}
+}
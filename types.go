@@ -0,0 +1,14 @@
+package bingx
+
+// Kline represents a single candlestick for a trading pair. BingX quotes
+// OHLCV fields as strings, matching every other price/quantity field this
+// package decodes (Order.Price, Trade.Price, Position.EntryPrice, ...).
+type Kline struct {
+	OpenTime  int64  `json:"openTime"`  // Candle open timestamp in milliseconds
+	Open      string `json:"open"`      // Open price
+	High      string `json:"high"`      // High price
+	Low       string `json:"low"`       // Low price
+	Close     string `json:"close"`     // Close price
+	Volume    string `json:"volume"`    // Base asset volume
+	CloseTime int64  `json:"closeTime"` // Candle close timestamp in milliseconds
+}
@@ -0,0 +1,153 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// PrivateClient streams BingX's private user-data channels: order updates,
+// position updates, and balance updates. The caller is responsible for
+// obtaining and refreshing the listen key (see the listen-key REST methods
+// on bingx.Client) and passing the current key to NewPrivateClient; on
+// reconnect the client re-dials using the listen key it was created with, so
+// callers that rotate keys should create a new PrivateClient instead of
+// reusing the old one.
+type PrivateClient struct {
+	*Client
+}
+
+// NewPrivateClient dials the private user-data endpoint authenticated with
+// listenKey, obtained via the listen-key lifecycle on bingx.Client.
+func NewPrivateClient(ctx context.Context, listenKey string, cfg Config) (*PrivateClient, error) {
+	url := cfg.URL
+	if url == "" {
+		url = defaultPrivateURL
+	}
+	url = fmt.Sprintf("%s?listenKey=%s", url, listenKey)
+	cfg.URL = url
+
+	c, err := newClient(ctx, url, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &PrivateClient{Client: c}, nil
+}
+
+// Order represents an order update pushed on the user-data stream.
+type Order struct {
+	Symbol        string `json:"symbol"`        // Trading pair, e.g. "BTC-USDT"
+	OrderID       string `json:"orderId"`       // Order ID
+	ClientOrderID string `json:"clientOrderId"` // Client order ID
+	Side          string `json:"side"`          // Order side: BUY, SELL
+	PositionSide  string `json:"positionSide"`  // Position side: LONG, SHORT
+	Type          string `json:"type"`          // Order type
+	Status        string `json:"status"`        // Order status
+	Price         string `json:"price"`         // Order price
+	Quantity      string `json:"quantity"`      // Order quantity
+	ExecutedQty   string `json:"executedQty"`   // Executed quantity
+	AvgPrice      string `json:"avgPrice"`      // Average execution price
+	Time          int64  `json:"time"`          // Event timestamp in milliseconds
+}
+
+// Position represents a position update pushed on the user-data stream.
+type Position struct {
+	Symbol           string `json:"symbol"`           // Trading pair, e.g. "BTC-USDT"
+	PositionSide     string `json:"positionSide"`     // Position side: LONG, SHORT
+	PositionAmt      string `json:"positionAmt"`      // Position quantity
+	EntryPrice       string `json:"entryPrice"`       // Average entry price
+	UnrealizedProfit string `json:"unrealizedProfit"` // Unrealized PnL
+	Leverage         string `json:"leverage"`         // Current leverage
+	Time             int64  `json:"time"`             // Event timestamp in milliseconds
+}
+
+// Balance represents an account balance update pushed on the user-data stream.
+type Balance struct {
+	Asset            string `json:"asset"`            // Asset, e.g. "USDT"
+	Balance          string `json:"balance"`          // Total balance
+	AvailableBalance string `json:"availableBalance"` // Available balance
+	Time             int64  `json:"time"`             // Event timestamp in milliseconds
+}
+
+// subscribePrivate registers a handler under the distinct registration key
+// (not necessarily the same as the BingX event type it matches against,
+// since SubscribePositions and SubscribeBalance both match "ACCOUNT_UPDATE"
+// but must be registered separately so neither overwrites the other in
+// Client.handlers), decoding frames via decode and invoking fn on success.
+func subscribePrivate(c *Client, key, eventType string, decode func(raw json.RawMessage) (bool, error)) error {
+	h := handler{
+		topic: key,
+		decode: func(data []byte) error {
+			var env struct {
+				E string `json:"e"`
+			}
+			if err := json.Unmarshal(data, &env); err != nil {
+				return err
+			}
+			if env.E != eventType {
+				return fmt.Errorf("bingx/ws: event %q does not match %q", env.E, eventType)
+			}
+			matched, err := decode(data)
+			if err != nil {
+				return err
+			}
+			if !matched {
+				return fmt.Errorf("bingx/ws: event %q payload did not match", eventType)
+			}
+			return nil
+		},
+	}
+	// The private stream pushes to the listen-key connection automatically
+	// once subscribed; no explicit subscribe frame is required per BingX's
+	// user-data protocol, but we still register the handler for dispatch.
+	c.mu.Lock()
+	c.handlers[key] = h
+	c.mu.Unlock()
+	return nil
+}
+
+// SubscribeOrders invokes handler for every order update on the user-data stream.
+func (c *PrivateClient) SubscribeOrders(handler func(Order)) error {
+	return subscribePrivate(c.Client, "ORDER_TRADE_UPDATE", "ORDER_TRADE_UPDATE", func(raw json.RawMessage) (bool, error) {
+		var env struct {
+			Order Order `json:"o"`
+		}
+		if err := json.Unmarshal(raw, &env); err != nil {
+			return false, err
+		}
+		handler(env.Order)
+		return true, nil
+	})
+}
+
+// SubscribePositions invokes handler for every position update on the user-data stream.
+func (c *PrivateClient) SubscribePositions(handler func(Position)) error {
+	return subscribePrivate(c.Client, "ACCOUNT_UPDATE/positions", "ACCOUNT_UPDATE", func(raw json.RawMessage) (bool, error) {
+		var env struct {
+			Positions []Position `json:"positions"`
+		}
+		if err := json.Unmarshal(raw, &env); err != nil {
+			return false, err
+		}
+		for _, p := range env.Positions {
+			handler(p)
+		}
+		return len(env.Positions) > 0, nil
+	})
+}
+
+// SubscribeBalance invokes handler for every balance update on the user-data stream.
+func (c *PrivateClient) SubscribeBalance(handler func(Balance)) error {
+	return subscribePrivate(c.Client, "ACCOUNT_UPDATE/balances", "ACCOUNT_UPDATE", func(raw json.RawMessage) (bool, error) {
+		var env struct {
+			Balances []Balance `json:"balances"`
+		}
+		if err := json.Unmarshal(raw, &env); err != nil {
+			return false, err
+		}
+		for _, b := range env.Balances {
+			handler(b)
+		}
+		return len(env.Balances) > 0, nil
+	})
+}
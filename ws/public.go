@@ -0,0 +1,109 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/suhostersky/bingx"
+)
+
+// PublicClient streams BingX's public market-data channels: klines, order
+// book depth, trades, and mark price. Create one with NewPublicClient.
+type PublicClient struct {
+	*Client
+}
+
+// NewPublicClient dials the public market-data endpoint.
+func NewPublicClient(ctx context.Context, cfg Config) (*PublicClient, error) {
+	url := cfg.URL
+	if url == "" {
+		url = defaultPublicURL
+	}
+	cfg.URL = url
+
+	c, err := newClient(ctx, url, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &PublicClient{Client: c}, nil
+}
+
+// Depth represents an order book depth update.
+type Depth struct {
+	Symbol string     `json:"symbol"` // Trading pair, e.g. "BTC-USDT"
+	Bids   [][]string `json:"bids"`   // [price, quantity] pairs, highest first
+	Asks   [][]string `json:"asks"`   // [price, quantity] pairs, lowest first
+	Time   int64      `json:"time"`   // Event timestamp in milliseconds
+}
+
+// Trade represents a single executed trade.
+type Trade struct {
+	Symbol   string `json:"symbol"`   // Trading pair, e.g. "BTC-USDT"
+	Price    string `json:"price"`    // Trade price
+	Quantity string `json:"quantity"` // Trade quantity
+	Side     string `json:"side"`     // Taker side: BUY, SELL
+	Time     int64  `json:"time"`     // Trade timestamp in milliseconds
+}
+
+// MarkPrice represents a mark price / funding rate update.
+type MarkPrice struct {
+	Symbol      string `json:"symbol"`      // Trading pair, e.g. "BTC-USDT"
+	MarkPrice   string `json:"markPrice"`   // Current mark price
+	FundingRate string `json:"fundingRate"` // Current funding rate
+	Time        int64  `json:"time"`        // Event timestamp in milliseconds
+}
+
+type dataEnvelope struct {
+	DataType string          `json:"dataType"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// subscribeTopic registers a typed handler for topic, decoding only frames
+// whose "dataType" field matches it.
+func subscribeTopic[T any](c *Client, topic string, fn func(T)) error {
+	h := handler{
+		topic: topic,
+		decode: func(data []byte) error {
+			var env dataEnvelope
+			if err := json.Unmarshal(data, &env); err != nil {
+				return err
+			}
+			if env.DataType != topic {
+				return fmt.Errorf("bingx/ws: dataType %q does not match topic %q", env.DataType, topic)
+			}
+			var payload T
+			if err := json.Unmarshal(env.Data, &payload); err != nil {
+				return err
+			}
+			fn(payload)
+			return nil
+		},
+	}
+	return c.subscribe(topic, subscribeFrame(topic), h)
+}
+
+// SubscribeKline subscribes to candlestick updates for symbol at interval
+// (e.g. "1m", "5m", "1h"), invoking handler for every new kline.
+func (c *PublicClient) SubscribeKline(symbol, interval string, handler func(bingx.Kline)) error {
+	topic := fmt.Sprintf("%s@kline_%s", symbol, interval)
+	return subscribeTopic(c.Client, topic, handler)
+}
+
+// SubscribeDepth subscribes to order book depth updates for symbol.
+func (c *PublicClient) SubscribeDepth(symbol string, handler func(Depth)) error {
+	topic := fmt.Sprintf("%s@depth", symbol)
+	return subscribeTopic(c.Client, topic, handler)
+}
+
+// SubscribeTrades subscribes to executed trade updates for symbol.
+func (c *PublicClient) SubscribeTrades(symbol string, handler func(Trade)) error {
+	topic := fmt.Sprintf("%s@trade", symbol)
+	return subscribeTopic(c.Client, topic, handler)
+}
+
+// SubscribeMarkPrice subscribes to mark price and funding rate updates for symbol.
+func (c *PublicClient) SubscribeMarkPrice(symbol string, handler func(MarkPrice)) error {
+	topic := fmt.Sprintf("%s@markPrice", symbol)
+	return subscribeTopic(c.Client, topic, handler)
+}
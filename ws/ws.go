@@ -0,0 +1,346 @@
+// Package ws provides a streaming client for BingX's public and private
+// Perpetual Swap WebSocket feeds.
+package ws
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	defaultPublicURL  = "wss://open-api-swap.bingx.com/swap-market"
+	defaultPrivateURL = "wss://open-api-swap.bingx.com/swap-market"
+
+	pingInterval   = 15 * time.Second
+	pongWait       = 30 * time.Second
+	writeWait      = 10 * time.Second
+	initialBackoff = 1 * time.Second
+	maxBackoff     = 30 * time.Second
+)
+
+var ErrClosed = errors.New("bingx/ws: client is closed")
+
+// Logger is the subset of a logger used to report connection events.
+// It defaults to the standard library logger when not set in Config.
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+// Config holds configuration for creating a new Client.
+type Config struct {
+	URL    string // optional, defaults to the public or private endpoint
+	Logger Logger // optional, defaults to log.Default()
+}
+
+// handler is stored per-topic so reconnects can re-subscribe and re-dispatch.
+type handler struct {
+	topic  string
+	decode func(data []byte) error
+
+	// resubscribe is true for handlers registered through subscribe(), which
+	// sent a real wire subscribe frame and so need it resent on reconnect.
+	// Private handlers (see subscribePrivate) are registered directly into
+	// Client.handlers without ever going through subscribe() and must stay
+	// false, since their "topic" is never a valid BingX dataType to resend.
+	resubscribe bool
+}
+
+// Client manages a single WebSocket connection, re-subscribing all active
+// topics on reconnect and fanning out decompressed frames to per-topic
+// handlers registered via the Subscribe* methods.
+type Client struct {
+	url    string
+	logger Logger
+
+	mu       sync.Mutex
+	conn     *websocket.Conn
+	handlers map[string]handler
+	closed   bool
+	closeCh  chan struct{}
+	wg       sync.WaitGroup
+
+	// writeMu serializes every write to conn (ping, pong reply, subscribe,
+	// resubscribe): gorilla/websocket forbids concurrent writers on the same
+	// connection and panics when it catches one.
+	writeMu sync.Mutex
+}
+
+// newClient dials url and starts the keepalive and fan-out goroutines.
+func newClient(ctx context.Context, url string, cfg Config) (*Client, error) {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	c := &Client{
+		url:      url,
+		logger:   logger,
+		handlers: make(map[string]handler),
+		closeCh:  make(chan struct{}),
+	}
+
+	if err := c.connect(ctx); err != nil {
+		return nil, err
+	}
+
+	c.wg.Add(1)
+	go c.readLoop()
+
+	return c, nil
+}
+
+func (c *Client) connect(ctx context.Context) error {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, c.url, nil)
+	if err != nil {
+		return fmt.Errorf("bingx/ws: dial: %w", err)
+	}
+
+	conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		conn.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+
+	c.mu.Lock()
+	old := c.conn
+	c.conn = conn
+	c.mu.Unlock()
+
+	// Close the connection we're replacing; on reconnect after a read error
+	// it's usually already dead, but closing it anyway releases its socket
+	// and goroutines instead of leaking them on every reconnect.
+	if old != nil {
+		_ = old.Close()
+	}
+
+	return nil
+}
+
+// subscribe registers h under topic, marks it for resubscribeAll, and sends
+// the subscribe frame on the current connection.
+func (c *Client) subscribe(topic string, payload interface{}, h handler) error {
+	h.resubscribe = true
+
+	c.mu.Lock()
+	c.handlers[topic] = h
+	c.mu.Unlock()
+
+	return c.writeJSON(payload)
+}
+
+// writeJSON writes v as a JSON frame on the current connection, serialized
+// against every other writer via writeMu.
+func (c *Client) writeJSON(v interface{}) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	c.mu.Lock()
+	conn := c.conn
+	c.mu.Unlock()
+	if conn == nil {
+		return ErrClosed
+	}
+
+	conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return conn.WriteJSON(v)
+}
+
+// writeMessage writes a raw control/data frame on the current connection,
+// serialized against every other writer via writeMu.
+func (c *Client) writeMessage(messageType int, data []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	c.mu.Lock()
+	conn := c.conn
+	c.mu.Unlock()
+	if conn == nil {
+		return ErrClosed
+	}
+
+	conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return conn.WriteMessage(messageType, data)
+}
+
+// readLoop reads frames off the current connection, ping-keepalives it,
+// gunzips payloads, and dispatches decoded messages to their topic handler.
+// On any read error it reconnects with exponential backoff and re-subscribes
+// every active topic before resuming.
+func (c *Client) readLoop() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	go func() {
+		for {
+			select {
+			case <-c.closeCh:
+				return
+			case <-ticker.C:
+				_ = c.writeMessage(websocket.PingMessage, nil)
+			}
+		}
+	}()
+
+	backoff := initialBackoff
+	for {
+		select {
+		case <-c.closeCh:
+			return
+		default:
+		}
+
+		c.mu.Lock()
+		conn := c.conn
+		c.mu.Unlock()
+		if conn == nil {
+			return
+		}
+
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			select {
+			case <-c.closeCh:
+				return
+			default:
+			}
+			c.logger.Printf("bingx/ws: read error, reconnecting: %v", err)
+			if err := c.reconnectWithBackoff(&backoff); err != nil {
+				c.logger.Printf("bingx/ws: giving up reconnecting: %v", err)
+				return
+			}
+			continue
+		}
+		backoff = initialBackoff
+
+		msg, err := maybeGunzip(data)
+		if err != nil {
+			c.logger.Printf("bingx/ws: gunzip error: %v", err)
+			continue
+		}
+
+		if string(msg) == "Ping" {
+			_ = c.writeJSON(json.RawMessage(`"Pong"`))
+			continue
+		}
+
+		c.dispatch(msg)
+	}
+}
+
+// dispatch routes a decompressed frame to every handler whose decode reports
+// no error, since BingX frames don't carry a stable topic key across all
+// channels, more than one handler can legitimately match the same frame
+// (e.g. SubscribePositions and SubscribeBalance both watch ACCOUNT_UPDATE),
+// and each handler knows how to recognize its own payload shape.
+func (c *Client) dispatch(msg []byte) {
+	c.mu.Lock()
+	handlers := make([]handler, 0, len(c.handlers))
+	for _, h := range c.handlers {
+		handlers = append(handlers, h)
+	}
+	c.mu.Unlock()
+
+	for _, h := range handlers {
+		_ = h.decode(msg)
+	}
+}
+
+func (c *Client) reconnectWithBackoff(backoff *time.Duration) error {
+	for {
+		select {
+		case <-c.closeCh:
+			return ErrClosed
+		case <-time.After(*backoff):
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := c.connect(ctx)
+		cancel()
+		if err == nil {
+			return c.resubscribeAll()
+		}
+
+		c.logger.Printf("bingx/ws: reconnect attempt failed: %v", err)
+		*backoff *= 2
+		if *backoff > maxBackoff {
+			*backoff = maxBackoff
+		}
+		// jitter to avoid a thundering herd of reconnects
+		*backoff += time.Duration(rand.Int63n(int64(time.Second)))
+	}
+}
+
+// resubscribeAll resends the wire subscribe frame for every handler that
+// originally came from a real subscribe() call. Handlers registered
+// directly (the private user-data channels; see subscribePrivate) never had
+// a wire subscribe frame in the first place and are skipped.
+func (c *Client) resubscribeAll() error {
+	c.mu.Lock()
+	topics := make([]string, 0, len(c.handlers))
+	for topic, h := range c.handlers {
+		if h.resubscribe {
+			topics = append(topics, topic)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, topic := range topics {
+		if err := c.writeJSON(subscribeFrame(topic)); err != nil {
+			return fmt.Errorf("bingx/ws: resubscribe %s: %w", topic, err)
+		}
+	}
+	return nil
+}
+
+func subscribeFrame(topic string) map[string]string {
+	return map[string]string{
+		"id":       topic,
+		"reqType":  "sub",
+		"dataType": topic,
+	}
+}
+
+func maybeGunzip(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0x1f || data[1] != 0x8b {
+		return data, nil
+	}
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// Close terminates the connection and stops the keepalive and fan-out
+// goroutines. It is safe to call more than once.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	conn := c.conn
+	c.mu.Unlock()
+
+	close(c.closeCh)
+	if conn != nil {
+		_ = conn.Close()
+	}
+	c.wg.Wait()
+	return nil
+}